@@ -0,0 +1,133 @@
+package parse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+)
+
+// BatchOp describes a single operation to include in a Batch call.
+type BatchOp struct {
+	Method string
+	Path   string
+	Body   interface{}
+}
+
+type batchRequest struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Body   interface{} `json:"body,omitempty"`
+}
+
+// BatchResult is the decoded outcome of a single Batch operation.
+type BatchResult struct {
+	Success json.RawMessage `json:"success"`
+	Error   *Error          `json:"error"`
+}
+
+// maxBatchOps is the most operations Parse allows in a single /batch call.
+// Larger op slices are automatically split into sequential sub-batches.
+const maxBatchOps = 50
+
+// BatchError reports the per-operation failures from a Batch call. Errors
+// is indexed the same as the ops/results passed to Batch; a nil entry means
+// that operation succeeded.
+type BatchError struct {
+	Errors []error
+}
+
+func (e *BatchError) Error() string {
+	var failed int
+	for _, err := range e.Errors {
+		if err != nil {
+			failed++
+		}
+	}
+	return fmt.Sprintf("parse: %d of %d batch operations failed", failed, len(e.Errors))
+}
+
+// Batch performs the given operations as a single call to Parse's /batch
+// endpoint. results, if non-nil, must be a pointer to a slice with the same
+// length as ops; the success payload of each operation is decoded into the
+// corresponding element. Per-operation failures are returned as a
+// *BatchError rather than failing the whole call.
+func (c *Client) Batch(ops []BatchOp, results interface{}) (*http.Response, error) {
+	return c.BatchContext(context.Background(), ops, results)
+}
+
+// BatchContext is like Batch but observes ctx's cancellation and deadline.
+// ops longer than Parse's limit of 50 are automatically split into
+// sequential sub-batches; the returned results and BatchError are indexed
+// as if it had been a single call.
+func (c *Client) BatchContext(ctx context.Context, ops []BatchOp, results interface{}) (*http.Response, error) {
+	resultsVal, err := batchResultsValue(results, len(ops))
+	if err != nil {
+		return nil, err
+	}
+
+	var res *http.Response
+	batchErr := &BatchError{Errors: make([]error, len(ops))}
+	var anyErr bool
+
+	for start := 0; start < len(ops); start += maxBatchOps {
+		end := start + maxBatchOps
+		if end > len(ops) {
+			end = len(ops)
+		}
+		chunk := ops[start:end]
+
+		requests := make([]batchRequest, len(chunk))
+		for i, op := range chunk {
+			requests[i] = batchRequest{Method: op.Method, Path: op.Path, Body: op.Body}
+		}
+		body := struct {
+			Requests []batchRequest `json:"requests"`
+		}{Requests: requests}
+
+		var raw []BatchResult
+		res, err = c.PostContext(ctx, &url.URL{Path: "batch"}, &body, &raw)
+		if err != nil {
+			return res, err
+		}
+
+		for i, r := range raw {
+			idx := start + i
+			if i >= len(chunk) {
+				return res, errors.New("parse: batch response had more results than requested ops")
+			}
+			if r.Error != nil {
+				batchErr.Errors[idx] = r.Error
+				anyErr = true
+				continue
+			}
+			if resultsVal.IsValid() && len(r.Success) > 0 {
+				if err := json.Unmarshal(r.Success, resultsVal.Index(idx).Addr().Interface()); err != nil {
+					return res, err
+				}
+			}
+		}
+	}
+	if anyErr {
+		return res, batchErr
+	}
+	return res, nil
+}
+
+func batchResultsValue(results interface{}, opCount int) (reflect.Value, error) {
+	if results == nil {
+		return reflect.Value{}, nil
+	}
+	v := reflect.ValueOf(results)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Slice {
+		return reflect.Value{}, errors.New("parse: results must be a pointer to a slice")
+	}
+	v = v.Elem()
+	if v.Len() != opCount {
+		return reflect.Value{}, errors.New("parse: results must have the same length as ops")
+	}
+	return v, nil
+}
@@ -0,0 +1,137 @@
+package parse_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/facebookgo/ensure"
+	"github.com/facebookgo/parse"
+)
+
+func TestBatchSuccess(t *testing.T) {
+	t.Parallel()
+	c := &parse.Client{
+		Credentials: defaultRestAPIKey,
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			ensure.DeepEqual(t, r.URL.Path, "/1/batch")
+			return &http.Response{
+				StatusCode: 200,
+				Body: ioutil.NopCloser(strings.NewReader(
+					`[{"success":{"objectId":"a"}},{"success":{"objectId":"b"}}]`,
+				)),
+			}, nil
+		}),
+	}
+
+	ops := []parse.BatchOp{
+		{Method: "POST", Path: "/1/classes/GameScore", Body: map[string]int{"score": 1}},
+		{Method: "POST", Path: "/1/classes/GameScore", Body: map[string]int{"score": 2}},
+	}
+	results := make([]struct {
+		ObjectID string `json:"objectId"`
+	}, len(ops))
+	_, err := c.Batch(ops, &results)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, results[0].ObjectID, "a")
+	ensure.DeepEqual(t, results[1].ObjectID, "b")
+}
+
+func TestBatchPartialFailure(t *testing.T) {
+	t.Parallel()
+	c := &parse.Client{
+		Credentials: defaultRestAPIKey,
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Body: ioutil.NopCloser(strings.NewReader(
+					`[{"success":{"objectId":"a"}},{"error":{"code":101,"error":"not found"}}]`,
+				)),
+			}, nil
+		}),
+	}
+
+	ops := []parse.BatchOp{
+		{Method: "POST", Path: "/1/classes/GameScore"},
+		{Method: "DELETE", Path: "/1/classes/GameScore/missing"},
+	}
+	_, err := c.Batch(ops, nil)
+	ensure.NotNil(t, err)
+
+	batchErr, ok := err.(*parse.BatchError)
+	if !ok {
+		t.Fatalf("expected a *parse.BatchError, got %T", err)
+	}
+	ensure.Nil(t, batchErr.Errors[0])
+	ensure.NotNil(t, batchErr.Errors[1])
+}
+
+func TestBatchSplitsLargeOpLists(t *testing.T) {
+	t.Parallel()
+	var calls int
+	var opsSeen []int
+	c := &parse.Client{
+		Credentials: defaultRestAPIKey,
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			calls++
+			var body struct {
+				Requests []struct {
+					Path string `json:"path"`
+				} `json:"requests"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+			opsSeen = append(opsSeen, len(body.Requests))
+			results := make([]string, len(body.Requests))
+			for i := range results {
+				results[i] = `{"success":{}}`
+			}
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader("[" + strings.Join(results, ",") + "]")),
+			}, nil
+		}),
+	}
+
+	ops := make([]parse.BatchOp, 75)
+	for i := range ops {
+		ops[i] = parse.BatchOp{Method: "POST", Path: "/1/classes/GameScore"}
+	}
+	_, err := c.Batch(ops, nil)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, calls, 2)
+	ensure.DeepEqual(t, opsSeen, []int{50, 25})
+}
+
+func TestBatchTooManyResults(t *testing.T) {
+	t.Parallel()
+	c := &parse.Client{
+		Credentials: defaultRestAPIKey,
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Body: ioutil.NopCloser(strings.NewReader(
+					`[{"success":{"objectId":"a"}},{"success":{"objectId":"b"}}]`,
+				)),
+			}, nil
+		}),
+	}
+
+	ops := []parse.BatchOp{{Method: "POST", Path: "/1/classes/GameScore"}}
+	results := make([]struct {
+		ObjectID string `json:"objectId"`
+	}, len(ops))
+	_, err := c.Batch(ops, &results)
+	ensure.NotNil(t, err)
+}
+
+func TestBatchResultsLengthMismatch(t *testing.T) {
+	t.Parallel()
+	c := &parse.Client{Credentials: defaultRestAPIKey}
+	var results []struct{}
+	_, err := c.Batch([]parse.BatchOp{{Method: "GET", Path: "/1/classes/GameScore/a"}}, &results)
+	ensure.NotNil(t, err)
+}
@@ -0,0 +1,90 @@
+package parse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+)
+
+// ErrNoResults is returned by Query.First when the query matched nothing.
+var ErrNoResults = errors.New("parse: query returned no results")
+
+// url returns the REST endpoint for the Query's className.
+func (q *Query) url() (*url.URL, error) {
+	if q.client == nil || q.className == "" {
+		return nil, errors.New("parse: query has no className, build it with (*Client).NewQuery")
+	}
+	return &url.URL{Path: "classes/" + q.className}, nil
+}
+
+// Find executes the Query and decodes the matching objects into dst, which
+// must be a pointer to a slice.
+func (q *Query) Find(ctx context.Context, dst interface{}) error {
+	u, err := q.url()
+	if err != nil {
+		return err
+	}
+	v, err := ParamValues(q)
+	if err != nil {
+		return err
+	}
+	u.RawQuery = v.Encode()
+
+	var res struct {
+		Results json.RawMessage `json:"results"`
+	}
+	if _, err := q.client.GetContext(ctx, u, &res); err != nil {
+		return err
+	}
+	return json.Unmarshal(res.Results, dst)
+}
+
+// First executes the Query with a limit of 1 and decodes the first matching
+// object into dst. It returns ErrNoResults if nothing matched.
+func (q *Query) First(ctx context.Context, dst interface{}) error {
+	if q.err != nil {
+		return q.err
+	}
+	first := *q
+	first.limit = 1
+	first.limitSet = true
+
+	var results []json.RawMessage
+	if err := first.Find(ctx, &results); err != nil {
+		return err
+	}
+	if len(results) == 0 {
+		return ErrNoResults
+	}
+	return json.Unmarshal(results[0], dst)
+}
+
+// Count returns the number of objects matching the Query, ignoring Limit,
+// Skip, Include, Keys and Order.
+func (q *Query) Count(ctx context.Context) (int64, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	u, err := q.url()
+	if err != nil {
+		return 0, err
+	}
+	counting := *q
+	counting.limitSet, counting.skip = false, 0
+	counting.order, counting.include, counting.keys = nil, nil, nil
+
+	v, err := ParamValues(&counting, ParamCount(true), ParamLimit(0))
+	if err != nil {
+		return 0, err
+	}
+	u.RawQuery = v.Encode()
+
+	var res struct {
+		Count int64 `json:"count"`
+	}
+	if _, err := q.client.GetContext(ctx, u, &res); err != nil {
+		return 0, err
+	}
+	return res.Count, nil
+}
@@ -0,0 +1,137 @@
+package parse_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/facebookgo/ensure"
+	"github.com/facebookgo/parse"
+)
+
+type gameScore struct {
+	PlayerName string `json:"playerName"`
+	Score      int    `json:"score"`
+}
+
+func TestClassQueryFind(t *testing.T) {
+	t.Parallel()
+	c := &parse.Client{
+		Credentials: defaultRestAPIKey,
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			ensure.DeepEqual(t, r.URL.Path, "/1/classes/GameScore")
+			v, err := url.ParseQuery(r.URL.RawQuery)
+			ensure.Nil(t, err)
+			ensure.DeepEqual(t, v.Get("where"), `{"score":{"$gt":1000}}`)
+			ensure.DeepEqual(t, v.Get("order"), "-score")
+			ensure.DeepEqual(t, v.Get("limit"), "50")
+			ensure.DeepEqual(t, v.Get("include"), "player")
+			return &http.Response{
+				StatusCode: 200,
+				Body: ioutil.NopCloser(strings.NewReader(
+					`{"results":[{"playerName":"Sean","score":1337}]}`,
+				)),
+			}, nil
+		}),
+	}
+
+	var results []gameScore
+	err := c.NewQuery("GameScore").
+		GreaterThan("score", 1000).
+		Include("player").
+		Order("-score").
+		Limit(50).
+		Find(context.Background(), &results)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(results), 1)
+	ensure.DeepEqual(t, results[0].PlayerName, "Sean")
+	ensure.DeepEqual(t, results[0].Score, 1337)
+}
+
+func TestClassQueryFirst(t *testing.T) {
+	t.Parallel()
+	c := &parse.Client{
+		Credentials: defaultRestAPIKey,
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			v, err := url.ParseQuery(r.URL.RawQuery)
+			ensure.Nil(t, err)
+			ensure.DeepEqual(t, v.Get("limit"), "1")
+			return &http.Response{
+				StatusCode: 200,
+				Body: ioutil.NopCloser(strings.NewReader(
+					`{"results":[{"playerName":"Sean","score":1337}]}`,
+				)),
+			}, nil
+		}),
+	}
+
+	var result gameScore
+	err := c.NewQuery("GameScore").EqualTo("playerName", "Sean").First(context.Background(), &result)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, result.Score, 1337)
+}
+
+func TestClassQueryFirstNoResults(t *testing.T) {
+	t.Parallel()
+	c := &parse.Client{
+		Credentials: defaultRestAPIKey,
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"results":[]}`)),
+			}, nil
+		}),
+	}
+
+	var result gameScore
+	err := c.NewQuery("GameScore").First(context.Background(), &result)
+	ensure.DeepEqual(t, err, parse.ErrNoResults)
+}
+
+func TestClassQueryCount(t *testing.T) {
+	t.Parallel()
+	c := &parse.Client{
+		Credentials: defaultRestAPIKey,
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			v, err := url.ParseQuery(r.URL.RawQuery)
+			ensure.Nil(t, err)
+			ensure.DeepEqual(t, v.Get("count"), "1")
+			ensure.DeepEqual(t, v.Get("limit"), "0")
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"count":42,"results":[]}`)),
+			}, nil
+		}),
+	}
+
+	count, err := c.NewQuery("GameScore").Limit(50).GreaterThan("score", 1000).Count(context.Background())
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, count, int64(42))
+}
+
+func TestClassQueryRequiresClassName(t *testing.T) {
+	t.Parallel()
+	_, err := parse.NewQuery().EqualTo("a", 1).Count(context.Background())
+	ensure.NotNil(t, err)
+}
+
+func TestClassQueryMatchesQuery(t *testing.T) {
+	t.Parallel()
+	c := &parse.Client{}
+	sub := c.NewQuery("Team").EqualTo("city", "San Francisco")
+	actual, err := parse.ParamValues(c.NewQuery("GameScore").MatchesQuery("team", sub))
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, actual.Get("where"),
+		`{"team":{"$inQuery":{"className":"Team","where":{"city":"San Francisco"}}}}`)
+}
+
+func TestClassQueryMatchesQueryRequiresClassName(t *testing.T) {
+	t.Parallel()
+	_, err := parse.ParamValues(
+		parse.NewQuery().MatchesQuery("team", parse.NewQuery().EqualTo("city", "San Francisco")),
+	)
+	ensure.NotNil(t, err)
+}
@@ -0,0 +1,51 @@
+package parse
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// CallFunction invokes the Cloud Code function name with the given params
+// and decodes its result into result. Parse wraps a successful response in
+// a top level "result" key; that envelope is removed automatically before
+// decoding.
+func (c *Client) CallFunction(name string, params, result interface{}) (*http.Response, error) {
+	return c.CallFunctionContext(context.Background(), name, params, result)
+}
+
+// CallFunctionContext is like CallFunction but observes ctx's cancellation
+// and deadline.
+func (c *Client) CallFunctionContext(ctx context.Context, name string, params, result interface{}) (*http.Response, error) {
+	var envelope struct {
+		Result json.RawMessage `json:"result"`
+	}
+	res, err := c.PostContext(ctx, &url.URL{Path: "functions/" + name}, params, &envelope)
+	if err != nil {
+		return res, err
+	}
+	if result != nil && len(envelope.Result) > 0 {
+		if err := json.Unmarshal(envelope.Result, result); err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+// StartJob starts the Cloud Code background job name with the given params
+// and returns the job status id Parse assigns it, which can be used to poll
+// the _JobStatus class.
+func (c *Client) StartJob(name string, params interface{}) (string, *http.Response, error) {
+	return c.StartJobContext(context.Background(), name, params)
+}
+
+// StartJobContext is like StartJob but observes ctx's cancellation and
+// deadline.
+func (c *Client) StartJobContext(ctx context.Context, name string, params interface{}) (string, *http.Response, error) {
+	res, err := c.PostContext(ctx, &url.URL{Path: "jobs/" + name}, params, nil)
+	if err != nil {
+		return "", res, err
+	}
+	return res.Header.Get("X-Parse-Job-Status-Id"), res, nil
+}
@@ -0,0 +1,51 @@
+package parse_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/facebookgo/ensure"
+	"github.com/facebookgo/parse"
+)
+
+func TestCallFunction(t *testing.T) {
+	t.Parallel()
+	c := &parse.Client{
+		Credentials: defaultRestAPIKey,
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			ensure.DeepEqual(t, r.URL.Path, "/1/functions/hello")
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"result":{"greeting":"hi"}}`)),
+			}, nil
+		}),
+	}
+
+	var result struct {
+		Greeting string `json:"greeting"`
+	}
+	_, err := c.CallFunction("hello", map[string]string{"name": "Sean"}, &result)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, result.Greeting, "hi")
+}
+
+func TestStartJob(t *testing.T) {
+	t.Parallel()
+	c := &parse.Client{
+		Credentials: defaultRestAPIKey,
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			ensure.DeepEqual(t, r.URL.Path, "/1/jobs/cleanup")
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{"X-Parse-Job-Status-Id": []string{"abc123"}},
+				Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+			}, nil
+		}),
+	}
+
+	jobStatusID, _, err := c.StartJob("cleanup", nil)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, jobStatusID, "abc123")
+}
@@ -0,0 +1,37 @@
+package parse
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// GetCtx is an alias for GetContext, for callers that prefer the shorter
+// "Ctx" suffix used by some other Go HTTP clients.
+func (c *Client) GetCtx(ctx context.Context, u *url.URL, result interface{}) (*http.Response, error) {
+	return c.GetContext(ctx, u, result)
+}
+
+// PostCtx is an alias for PostContext, for callers that prefer the shorter
+// "Ctx" suffix used by some other Go HTTP clients.
+func (c *Client) PostCtx(ctx context.Context, u *url.URL, body, result interface{}) (*http.Response, error) {
+	return c.PostContext(ctx, u, body, result)
+}
+
+// PutCtx is an alias for PutContext, for callers that prefer the shorter
+// "Ctx" suffix used by some other Go HTTP clients.
+func (c *Client) PutCtx(ctx context.Context, u *url.URL, body, result interface{}) (*http.Response, error) {
+	return c.PutContext(ctx, u, body, result)
+}
+
+// DeleteCtx is an alias for DeleteContext, for callers that prefer the
+// shorter "Ctx" suffix used by some other Go HTTP clients.
+func (c *Client) DeleteCtx(ctx context.Context, u *url.URL, result interface{}) (*http.Response, error) {
+	return c.DeleteContext(ctx, u, result)
+}
+
+// DoCtx is an alias for DoContext, for callers that prefer the shorter
+// "Ctx" suffix used by some other Go HTTP clients.
+func (c *Client) DoCtx(ctx context.Context, req *http.Request, body, result interface{}) (*http.Response, error) {
+	return c.DoContext(ctx, req, body, result)
+}
@@ -0,0 +1,105 @@
+package parse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// FileResponse is Parse's response to a successful file upload.
+type FileResponse struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+type lenner interface {
+	Len() int
+}
+
+// UploadFile uploads body as a file named name with the given contentType
+// to Parse's /files endpoint. Unlike the other Client methods, body is
+// streamed as-is rather than being JSON encoded. When body is an io.Seeker
+// or exposes Len(), its length is used to set Content-Length; otherwise the
+// request is sent chunked.
+func (c *Client) UploadFile(name, contentType string, body io.Reader) (*FileResponse, *http.Response, error) {
+	return c.UploadFileContext(context.Background(), name, contentType, body)
+}
+
+// UploadFileContext is like UploadFile but observes ctx's cancellation and
+// deadline.
+func (c *Client) UploadFileContext(ctx context.Context, name, contentType string, body io.Reader) (*FileResponse, *http.Response, error) {
+	req := &http.Request{
+		Method: "POST",
+		URL:    &url.URL{Path: "files/" + name},
+		Header: http.Header{"Content-Type": []string{contentType}},
+		Body:   ioutil.NopCloser(body),
+	}
+
+	var knownLength bool
+	if s, ok := body.(io.Seeker); ok {
+		if n, ok := seekerLen(s); ok {
+			req.ContentLength = n
+			knownLength = true
+		}
+	}
+	if !knownLength {
+		if l, ok := body.(lenner); ok {
+			req.ContentLength = int64(l.Len())
+			knownLength = true
+		}
+	}
+	if !knownLength {
+		req.TransferEncoding = []string{"chunked"}
+	}
+
+	res, err := c.RoundTripContext(ctx, req)
+	if err != nil {
+		return nil, res, err
+	}
+	defer res.Body.Close()
+
+	var fr FileResponse
+	if err := json.NewDecoder(res.Body).Decode(&fr); err != nil {
+		return nil, res, err
+	}
+	return &fr, res, nil
+}
+
+// seekerLen returns the number of unread bytes left in s, leaving its
+// position unchanged, and false if that cannot be determined.
+func seekerLen(s io.Seeker) (int64, bool) {
+	cur, err := s.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, false
+	}
+	end, err := s.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, false
+	}
+	if _, err := s.Seek(cur, io.SeekStart); err != nil {
+		return 0, false
+	}
+	return end - cur, true
+}
+
+var errDeleteFileRequiresMasterKey = errors.New("parse: DeleteFile requires MasterKey credentials")
+
+// DeleteFile deletes the previously uploaded file named name. This
+// operation requires the Client to be configured with MasterKey
+// credentials.
+func (c *Client) DeleteFile(name string) (*http.Response, error) {
+	return c.DeleteFileContext(context.Background(), name)
+}
+
+// DeleteFileContext is like DeleteFile but observes ctx's cancellation and
+// deadline.
+func (c *Client) DeleteFileContext(ctx context.Context, name string) (*http.Response, error) {
+	if _, ok := c.Credentials.(MasterKey); !ok {
+		return nil, errDeleteFileRequiresMasterKey
+	}
+	return c.DeleteContext(ctx, &url.URL{Path: "files/" + name}, nil)
+}
@@ -0,0 +1,63 @@
+package parse_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/facebookgo/ensure"
+	"github.com/facebookgo/parse"
+)
+
+func TestUploadFile(t *testing.T) {
+	t.Parallel()
+	c := &parse.Client{
+		Credentials: defaultRestAPIKey,
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			ensure.DeepEqual(t, r.URL.Path, "/1/files/hello.txt")
+			ensure.DeepEqual(t, r.Header.Get("Content-Type"), "text/plain")
+			ensure.DeepEqual(t, r.ContentLength, int64(5))
+			return &http.Response{
+				StatusCode: 200,
+				Body: ioutil.NopCloser(strings.NewReader(
+					`{"name":"hello.txt","url":"http://files.parsetfss.com/hello.txt"}`,
+				)),
+			}, nil
+		}),
+	}
+
+	fr, _, err := c.UploadFile("hello.txt", "text/plain", bytes.NewReader([]byte("hello")))
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, fr.Name, "hello.txt")
+}
+
+func TestDeleteFileRequiresMasterKey(t *testing.T) {
+	t.Parallel()
+	c := &parse.Client{Credentials: defaultRestAPIKey}
+	_, err := c.DeleteFile("hello.txt")
+	if err == nil {
+		t.Fatal("was expecting error")
+	}
+}
+
+func TestDeleteFileWithMasterKey(t *testing.T) {
+	t.Parallel()
+	c := &parse.Client{
+		Credentials: parse.MasterKey{
+			ApplicationID: defaultApplicationID,
+			MasterKey:     "master",
+		},
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			ensure.DeepEqual(t, r.Method, "DELETE")
+			ensure.DeepEqual(t, r.URL.Path, "/1/files/hello.txt")
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+			}, nil
+		}),
+	}
+	_, err := c.DeleteFile("hello.txt")
+	ensure.Nil(t, err)
+}
@@ -0,0 +1,140 @@
+package parse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"reflect"
+)
+
+// maxPageSize is the largest page Parse will return for a single query.
+const maxPageSize = 1000
+
+// Iterator iterates over the results of a Parse query, transparently
+// fetching additional pages via ParamSkip/ParamLimit as needed.
+type Iterator struct {
+	client   *Client
+	u        *url.URL
+	params   []Param
+	pageSize uint64
+	ordered  bool
+
+	skip  uint64
+	page  []json.RawMessage
+	index int
+	done  bool
+	err   error
+}
+
+// Query returns an Iterator over the results of a GET to u with the given
+// params, fetching results maxPageSize (Parse's maximum of 1000) at a time.
+// Use PageSize to fetch smaller pages.
+func (c *Client) Query(u *url.URL, params ...Param) *Iterator {
+	it := &Iterator{
+		client:   c,
+		u:        u,
+		params:   params,
+		pageSize: maxPageSize,
+	}
+	if v, err := ParamValues(params...); err == nil && v.Get("order") != "" {
+		it.ordered = true
+	}
+	return it
+}
+
+// PageSize overrides the Iterator's default page size of 1000, Parse's
+// maximum, and returns the Iterator for chaining.
+func (it *Iterator) PageSize(n uint64) *Iterator {
+	it.pageSize = n
+	return it
+}
+
+// Next decodes the next result into dst and reports whether it succeeded.
+// It returns false once the results are exhausted or an error occurs; use
+// Err to tell the two apart.
+func (it *Iterator) Next(ctx context.Context, dst interface{}) bool {
+	if it.err != nil {
+		return false
+	}
+	if it.index >= len(it.page) {
+		if it.done {
+			return false
+		}
+		if !it.fetch(ctx) {
+			return false
+		}
+		if len(it.page) == 0 {
+			it.done = true
+			return false
+		}
+	}
+	if err := json.Unmarshal(it.page[it.index], dst); err != nil {
+		it.err = err
+		return false
+	}
+	it.index++
+	return true
+}
+
+func (it *Iterator) fetch(ctx context.Context) bool {
+	if it.skip > 0 && !it.ordered {
+		it.err = errors.New("parse: paging past the first page of unordered results is not stable; supply a ParamOrder")
+		return false
+	}
+
+	params := append(append([]Param{}, it.params...), ParamSkip(it.skip), ParamLimit(it.pageSize))
+	v, err := ParamValues(params...)
+	if err != nil {
+		it.err = err
+		return false
+	}
+	u := *it.u
+	u.RawQuery = v.Encode()
+
+	var res struct {
+		Results []json.RawMessage `json:"results"`
+	}
+	if _, err := it.client.GetContext(ctx, &u, &res); err != nil {
+		it.err = err
+		return false
+	}
+
+	it.page = res.Results
+	it.index = 0
+	it.skip += uint64(len(res.Results))
+	if uint64(len(res.Results)) < it.pageSize {
+		it.done = true
+	}
+	return true
+}
+
+// Err returns the first error, if any, encountered while iterating.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Close releases any resources held by the Iterator. It is always safe to
+// call, and exists for forward compatibility.
+func (it *Iterator) Close() {}
+
+// QueryAll materializes the full result set of a GET to u with the given
+// params into dst, which must be a pointer to a slice.
+func (c *Client) QueryAll(ctx context.Context, u *url.URL, dst interface{}, params ...Param) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Slice {
+		return errors.New("parse: dst must be a pointer to a slice")
+	}
+	slice := v.Elem()
+	elemType := slice.Type().Elem()
+
+	it := c.Query(u, params...)
+	for {
+		item := reflect.New(elemType)
+		if !it.Next(ctx, item.Interface()) {
+			break
+		}
+		slice.Set(reflect.Append(slice, item.Elem()))
+	}
+	return it.Err()
+}
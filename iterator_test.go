@@ -0,0 +1,130 @@
+package parse_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/facebookgo/ensure"
+	"github.com/facebookgo/parse"
+)
+
+func TestIteratorPaging(t *testing.T) {
+	t.Parallel()
+	pages := []string{
+		`{"results":[{"score":1},{"score":2}]}`,
+		`{"results":[{"score":3}]}`,
+	}
+	var call int
+	c := &parse.Client{
+		Credentials: defaultRestAPIKey,
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			body := pages[call]
+			call++
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+			}, nil
+		}),
+	}
+
+	it := c.Query(&url.URL{Path: "classes/GameScore"}, parse.ParamOrder("score")).PageSize(2)
+	var scores []int
+	ctx := context.Background()
+	for {
+		var v struct {
+			Score int `json:"score"`
+		}
+		if !it.Next(ctx, &v) {
+			break
+		}
+		scores = append(scores, v.Score)
+	}
+	ensure.Nil(t, it.Err())
+	ensure.DeepEqual(t, scores, []int{1, 2, 3})
+	ensure.DeepEqual(t, call, 2)
+}
+
+func TestIteratorUnorderedMultiPageErrors(t *testing.T) {
+	t.Parallel()
+	c := &parse.Client{
+		Credentials: defaultRestAPIKey,
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"results":[{"score":1},{"score":2}]}`)),
+			}, nil
+		}),
+	}
+
+	it := c.Query(&url.URL{Path: "classes/GameScore"}).PageSize(2)
+	ctx := context.Background()
+	var v struct {
+		Score int `json:"score"`
+	}
+	for i := 0; i < 3; i++ {
+		it.Next(ctx, &v)
+	}
+	if it.Err() == nil {
+		t.Fatal("was expecting error")
+	}
+}
+
+func TestIteratorOrderedViaQueryPages(t *testing.T) {
+	t.Parallel()
+	pages := []string{
+		`{"results":[{"score":1},{"score":2}]}`,
+		`{"results":[{"score":3}]}`,
+	}
+	var call int
+	c := &parse.Client{
+		Credentials: defaultRestAPIKey,
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			body := pages[call]
+			call++
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+			}, nil
+		}),
+	}
+
+	it := c.Query(&url.URL{Path: "classes/GameScore"}, parse.NewQuery().Order("-score")).PageSize(2)
+	var scores []int
+	ctx := context.Background()
+	for {
+		var v struct {
+			Score int `json:"score"`
+		}
+		if !it.Next(ctx, &v) {
+			break
+		}
+		scores = append(scores, v.Score)
+	}
+	ensure.Nil(t, it.Err())
+	ensure.DeepEqual(t, scores, []int{1, 2, 3})
+	ensure.DeepEqual(t, call, 2)
+}
+
+func TestQueryAll(t *testing.T) {
+	t.Parallel()
+	c := &parse.Client{
+		Credentials: defaultRestAPIKey,
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"results":[{"score":1},{"score":2}]}`)),
+			}, nil
+		}),
+	}
+
+	var results []struct {
+		Score int `json:"score"`
+	}
+	err := c.QueryAll(context.Background(), &url.URL{Path: "classes/GameScore"}, &results, parse.ParamOrder("score"))
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(results), 2)
+}
@@ -0,0 +1,39 @@
+package parse
+
+import "net/http"
+
+// RoundTripFunc is a single link in a Client's middleware chain. It receives
+// the outgoing request and a next function representing the remainder of
+// the chain (terminating in the underlying Transport), and must call next
+// exactly once to continue down the chain, returning whatever next returns
+// (or short-circuiting by returning its own response/error instead).
+type RoundTripFunc func(req *http.Request, next func(*http.Request) (*http.Response, error)) (*http.Response, error)
+
+// chain composes fns into a single function that invokes them in order,
+// each wrapping the next, terminating in final.
+func chain(fns []RoundTripFunc, final func(*http.Request) (*http.Response, error)) func(*http.Request) (*http.Response, error) {
+	next := final
+	for i := len(fns) - 1; i >= 0; i-- {
+		fn, rest := fns[i], next
+		next = func(req *http.Request) (*http.Response, error) {
+			return fn(req, rest)
+		}
+	}
+	return next
+}
+
+// userAgentMiddleware sets the library's User-Agent header.
+func userAgentMiddleware(req *http.Request, next func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	req.Header.Set(userAgentHeader, userAgent)
+	return next(req)
+}
+
+// credentialsMiddleware applies the Client's Credentials, if any.
+func (c *Client) credentialsMiddleware(req *http.Request, next func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	if c.Credentials != nil {
+		if err := c.Credentials.Modify(req); err != nil {
+			return nil, err
+		}
+	}
+	return next(req)
+}
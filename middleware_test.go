@@ -0,0 +1,74 @@
+package parse_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/facebookgo/ensure"
+	"github.com/facebookgo/parse"
+)
+
+func TestMiddlewareObservesRequest(t *testing.T) {
+	t.Parallel()
+	done := make(chan struct{})
+	var seenUserAgent string
+	c := &parse.Client{
+		Middlewares: []parse.RoundTripFunc{
+			func(req *http.Request, next func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+				seenUserAgent = req.Header.Get("User-Agent")
+				return next(req)
+			},
+		},
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			defer close(done)
+			return nil, errors.New("")
+		}),
+	}
+	c.Do(&http.Request{}, nil, nil)
+	<-done
+	ensure.DeepEqual(t, seenUserAgent, "")
+}
+
+func TestMiddlewareModifiesRequest(t *testing.T) {
+	t.Parallel()
+	done := make(chan struct{})
+	c := &parse.Client{
+		Middlewares: []parse.RoundTripFunc{
+			func(req *http.Request, next func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+				req.Header.Set("X-Tenant", "acme")
+				return next(req)
+			},
+		},
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			defer close(done)
+			ensure.DeepEqual(t, r.Header.Get("X-Tenant"), "acme")
+			ensure.NotDeepEqual(t, r.Header.Get("User-Agent"), "")
+			return nil, errors.New("")
+		}),
+	}
+	c.Do(&http.Request{}, nil, nil)
+	<-done
+}
+
+func TestMiddlewareShortCircuits(t *testing.T) {
+	t.Parallel()
+	errShortCircuit := errors.New("short circuited")
+	reached := false
+	c := &parse.Client{
+		Middlewares: []parse.RoundTripFunc{
+			func(req *http.Request, next func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+				return nil, errShortCircuit
+			},
+		},
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			reached = true
+			return nil, errors.New("")
+		}),
+	}
+	_, err := c.Do(&http.Request{}, nil, nil)
+	ensure.DeepEqual(t, err, errShortCircuit)
+	if reached {
+		t.Fatalf("expected Transport not to be reached after a middleware short-circuits")
+	}
+}
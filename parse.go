@@ -3,12 +3,16 @@ package parse
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
 	"net/url"
+	"strconv"
+	"time"
 )
 
 const (
@@ -115,6 +119,11 @@ func (t SessionToken) Modify(r *http.Request) error {
 type Error struct {
 	Message string `json:"error"`
 	Code    int    `json:"code"`
+
+	// HTTPStatus and RequestID are populated from the response and are not
+	// part of the JSON body Parse sends.
+	HTTPStatus int
+	RequestID  string
 }
 
 func (e *Error) Error() string {
@@ -129,6 +138,9 @@ func (e *Error) Error() string {
 	if e.Message != "" {
 		fmt.Fprintf(&buf, "message=%q", e.Message)
 	}
+	if e.RequestID != "" {
+		fmt.Fprintf(&buf, " (request_id=%s)", e.RequestID)
+	}
 	return buf.String()
 }
 
@@ -156,6 +168,129 @@ type Client struct {
 
 	// Credentials if set, will be included on every request.
 	Credentials Credentials
+
+	// RetryCount is the number of times a failed request will be retried
+	// before giving up. Zero (the default) disables retries.
+	RetryCount int
+
+	// RetryWaitMin and RetryWaitMax set the exponential backoff used between
+	// retries: RetryWaitMin doubles with each attempt up to RetryWaitMax, and
+	// uniform jitter of up to that delay again is added on top. When unset,
+	// reasonable defaults are used.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+
+	// RetryPolicy, when set, decides whether a given response/error pair
+	// should be retried and how long to wait before doing so. A zero
+	// duration means the default exponential backoff should be used
+	// instead. A Retry-After header on a 429 or 503 response takes
+	// precedence over the returned duration. When unset, a default policy retries network errors, 5xx
+	// responses and 429 responses for idempotent requests (GET, HEAD, PUT
+	// and DELETE); POST requests are only retried when RetryPolicy is set,
+	// since retrying a POST can duplicate side effects.
+	RetryPolicy func(*http.Response, error) (bool, time.Duration)
+
+	// RequestIDFunc, if set, generates the X-Request-Id sent on outgoing
+	// requests that don't already carry one (either set directly on the
+	// http.Request or injected into the context via WithRequestID). When
+	// unset, a random id is generated.
+	RequestIDFunc func() string
+
+	// Middlewares are applied, in order, around every request before it
+	// reaches Transport. They run outside of the built-in User-Agent and
+	// Credentials handling: they see the request before those headers are
+	// set, and any changes they make to those headers are overwritten.
+	Middlewares []RoundTripFunc
+}
+
+const (
+	defaultRetryWaitMin = 100 * time.Millisecond
+	defaultRetryWaitMax = 1 * time.Second
+)
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case "GET", "HEAD", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
+
+// shouldRetry reports whether the given attempt should be retried, and if
+// so how long to wait before doing so. A zero duration means the caller
+// should fall back to the default exponential backoff.
+func (c *Client) shouldRetry(req *http.Request, res *http.Response, err error) (bool, time.Duration) {
+	if c.RetryPolicy != nil {
+		return c.RetryPolicy(res, err)
+	}
+	if !isIdempotentMethod(req.Method) {
+		return false, 0
+	}
+	if res == nil {
+		// A network-level failure; no response was received at all.
+		return err != nil, 0
+	}
+	if res.StatusCode >= 500 || res.StatusCode == http.StatusTooManyRequests {
+		return true, 0
+	}
+	if isRetryableParseErrorCode(err) {
+		return true, 0
+	}
+	return false, 0
+}
+
+// retryableParseErrorCodes are Parse API error codes indicating the
+// request timed out or was throttled, and so is worth retrying even when
+// the HTTP status code alone wouldn't suggest it.
+var retryableParseErrorCodes = map[int]bool{124: true, 155: true, 159: true}
+
+func isRetryableParseErrorCode(err error) bool {
+	pe, ok := err.(*Error)
+	return ok && retryableParseErrorCodes[pe.Code]
+}
+
+// retryAfter extracts the delay requested by a Retry-After header on a 429
+// or 503 response, supporting both the delta-seconds and HTTP-date forms.
+// It returns zero if the response did not request a specific delay.
+func retryAfter(res *http.Response) time.Duration {
+	if res == nil {
+		return 0
+	}
+	if res.StatusCode != http.StatusTooManyRequests && res.StatusCode != http.StatusServiceUnavailable {
+		return 0
+	}
+	v := res.Header.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// backoff computes the exponential backoff delay for the given attempt
+// number: min(RetryWaitMax, RetryWaitMin*2^attempt) plus uniform jitter of
+// up to that same amount, so the result can reach twice RetryWaitMax.
+func (c *Client) backoff(attempt int) time.Duration {
+	min := c.RetryWaitMin
+	if min <= 0 {
+		min = defaultRetryWaitMin
+	}
+	max := c.RetryWaitMax
+	if max <= 0 {
+		max = defaultRetryWaitMax
+	}
+	d := min * time.Duration(uint64(1)<<uint(attempt))
+	if d <= 0 || d > max {
+		d = max
+	}
+	return d + time.Duration(rand.Int63n(int64(d)+1))
 }
 
 func (c *Client) transport() http.RoundTripper {
@@ -171,24 +306,44 @@ func (c *Client) Get(u *url.URL, result interface{}) (*http.Response, error) {
 	return c.Do(&http.Request{Method: "GET", URL: u}, nil, result)
 }
 
+// GetContext is like Get but observes ctx's cancellation and deadline.
+func (c *Client) GetContext(ctx context.Context, u *url.URL, result interface{}) (*http.Response, error) {
+	return c.DoContext(ctx, &http.Request{Method: "GET", URL: u}, nil, result)
+}
+
 // Post performs a POST method call on the given url with the given body and
 // unmarshal response into result.
 func (c *Client) Post(u *url.URL, body, result interface{}) (*http.Response, error) {
 	return c.Do(&http.Request{Method: "POST", URL: u}, body, result)
 }
 
+// PostContext is like Post but observes ctx's cancellation and deadline.
+func (c *Client) PostContext(ctx context.Context, u *url.URL, body, result interface{}) (*http.Response, error) {
+	return c.DoContext(ctx, &http.Request{Method: "POST", URL: u}, body, result)
+}
+
 // Put performs a PUT method call on the given url with the given body and
 // unmarshal response into result.
 func (c *Client) Put(u *url.URL, body, result interface{}) (*http.Response, error) {
 	return c.Do(&http.Request{Method: "PUT", URL: u}, body, result)
 }
 
+// PutContext is like Put but observes ctx's cancellation and deadline.
+func (c *Client) PutContext(ctx context.Context, u *url.URL, body, result interface{}) (*http.Response, error) {
+	return c.DoContext(ctx, &http.Request{Method: "PUT", URL: u}, body, result)
+}
+
 // Delete performs a DELETE method call on the given url and unmarshal response
 // into result.
 func (c *Client) Delete(u *url.URL, result interface{}) (*http.Response, error) {
 	return c.Do(&http.Request{Method: "DELETE", URL: u}, nil, result)
 }
 
+// DeleteContext is like Delete but observes ctx's cancellation and deadline.
+func (c *Client) DeleteContext(ctx context.Context, u *url.URL, result interface{}) (*http.Response, error) {
+	return c.DoContext(ctx, &http.Request{Method: "DELETE", URL: u}, nil, result)
+}
+
 // RoundTrip performs a RoundTrip ignoring the request and response bodies. It
 // is up to the caller to close them. This method modifies the request.
 func (c *Client) RoundTrip(req *http.Request) (*http.Response, error) {
@@ -220,14 +375,10 @@ func (c *Client) RoundTrip(req *http.Request) (*http.Response, error) {
 		req.Header = make(http.Header)
 	}
 
-	req.Header.Add(userAgentHeader, userAgent)
-	if c.Credentials != nil {
-		if err := c.Credentials.Modify(req); err != nil {
-			return nil, err
-		}
-	}
-
-	res, err := c.transport().RoundTrip(req)
+	fns := make([]RoundTripFunc, 0, len(c.Middlewares)+2)
+	fns = append(fns, c.Middlewares...)
+	fns = append(fns, userAgentMiddleware, c.credentialsMiddleware)
+	res, err := chain(fns, c.transport().RoundTrip)(req)
 	if err != nil {
 		return res, err
 	}
@@ -241,6 +392,8 @@ func (c *Client) RoundTrip(req *http.Request) (*http.Response, error) {
 		if len(body) > 0 {
 			var apiErr Error
 			if json.Unmarshal(body, &apiErr) == nil {
+				apiErr.HTTPStatus = res.StatusCode
+				apiErr.RequestID = res.Header.Get(requestIDHeader)
 				return res, &apiErr
 			}
 		}
@@ -253,26 +406,95 @@ func (c *Client) RoundTrip(req *http.Request) (*http.Response, error) {
 	return res, nil
 }
 
+// RoundTripContext is like RoundTrip but aborts the round trip as soon as ctx
+// is cancelled or its deadline is exceeded, regardless of whether the
+// underlying Transport is itself context-aware.
+func (c *Client) RoundTripContext(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if req.Header == nil {
+		req.Header = make(http.Header)
+	}
+	req.Header.Set(requestIDHeader, c.requestID(ctx, req))
+	req = req.WithContext(ctx)
+
+	type result struct {
+		res *http.Response
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		res, err := c.RoundTrip(req)
+		done <- result{res, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		go func() {
+			if r := <-done; r.res != nil && r.res.Body != nil {
+				r.res.Body.Close()
+			}
+		}()
+		return nil, ctx.Err()
+	case r := <-done:
+		return r.res, r.err
+	}
+}
+
 // Do performs a Parse API call. This method modifies the request and adds the
 // Authentication headers. The body is JSON encoded and for responses in the
 // 2xx or 3xx range the response will be JSON decoded into result, for others
 // an error of type Error will be returned.
 func (c *Client) Do(req *http.Request, body, result interface{}) (*http.Response, error) {
+	return c.DoContext(context.Background(), req, body, result)
+}
+
+// DoContext is like Do but observes ctx's cancellation and deadline, aborting
+// the in-flight request when ctx is done.
+func (c *Client) DoContext(ctx context.Context, req *http.Request, body, result interface{}) (*http.Response, error) {
 	// we need to buffer as Parse requires a Content-Length
+	var bd []byte
 	if body != nil {
-		bd, err := json.Marshal(body)
+		var err error
+		bd, err = json.Marshal(body)
 		if err != nil {
 			return nil, err
 		}
-		if req.Header == nil {
-			req.Header = make(http.Header)
-		}
-		req.Header.Set("Content-Type", "application/json")
-		req.Body = ioutil.NopCloser(bytes.NewReader(bd))
-		req.ContentLength = int64(len(bd))
 	}
 
-	res, err := c.RoundTrip(req)
+	var res *http.Response
+	var err error
+	for attempt := 0; ; attempt++ {
+		if bd != nil {
+			if req.Header == nil {
+				req.Header = make(http.Header)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			req.Body = ioutil.NopCloser(bytes.NewReader(bd))
+			req.ContentLength = int64(len(bd))
+		}
+
+		res, err = c.RoundTripContext(ctx, req)
+		if ctx.Err() != nil {
+			break
+		}
+
+		retry, wait := c.shouldRetry(req, res, err)
+		if !retry || attempt >= c.RetryCount {
+			break
+		}
+		if res != nil && res.Body != nil {
+			res.Body.Close()
+		}
+		if d := retryAfter(res); d > 0 {
+			wait = d
+		} else if wait == 0 {
+			wait = c.backoff(attempt)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
 	if err != nil {
 		return res, err
 	}
@@ -2,8 +2,10 @@ package parse_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -11,6 +13,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/facebookgo/ensure"
 	"github.com/facebookgo/parse"
@@ -161,6 +164,241 @@ func TestMethodHelpers(t *testing.T) {
 	ensure.DeepEqual(t, count, len(expected))
 }
 
+func TestContextMethodHelpers(t *testing.T) {
+	t.Parallel()
+	expected := []string{"GET", "POST", "PUT", "DELETE"}
+	count := 0
+	c := &parse.Client{
+		Credentials: defaultRestAPIKey,
+		BaseURL: &url.URL{
+			Scheme: "https",
+			Host:   "api.parse.com",
+			Path:   "/1/classes/Foo/",
+		},
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			ensure.DeepEqual(t, r.Method, expected[count])
+			count++
+			return nil, errors.New("")
+		}),
+	}
+	ctx := context.Background()
+	c.GetContext(ctx, nil, nil)
+	c.PostContext(ctx, nil, nil, nil)
+	c.PutContext(ctx, nil, nil, nil)
+	c.DeleteContext(ctx, nil, nil)
+	ensure.DeepEqual(t, count, len(expected))
+}
+
+func TestCtxMethodAliases(t *testing.T) {
+	t.Parallel()
+	expected := []string{"GET", "POST", "PUT", "DELETE"}
+	count := 0
+	c := &parse.Client{
+		Credentials: defaultRestAPIKey,
+		BaseURL: &url.URL{
+			Scheme: "https",
+			Host:   "api.parse.com",
+			Path:   "/1/classes/Foo/",
+		},
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			ensure.DeepEqual(t, r.Method, expected[count])
+			count++
+			return nil, errors.New("")
+		}),
+	}
+	ctx := context.Background()
+	c.GetCtx(ctx, nil, nil)
+	c.PostCtx(ctx, nil, nil, nil)
+	c.PutCtx(ctx, nil, nil, nil)
+	c.DeleteCtx(ctx, nil, nil)
+	ensure.DeepEqual(t, count, len(expected))
+}
+
+func TestDoContextCancelled(t *testing.T) {
+	t.Parallel()
+	block := make(chan struct{})
+	defer close(block)
+	c := &parse.Client{
+		Credentials: defaultRestAPIKey,
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			<-block
+			return nil, errors.New("should not get here")
+		}),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := c.GetContext(ctx, nil, nil)
+	ensure.DeepEqual(t, err, context.Canceled)
+}
+
+type closeSignalBody struct {
+	io.Reader
+	closed chan struct{}
+}
+
+func (b *closeSignalBody) Close() error {
+	close(b.closed)
+	return nil
+}
+
+func TestDoContextCancelledClosesLateResponseBody(t *testing.T) {
+	t.Parallel()
+	body := &closeSignalBody{Reader: bytes.NewReader(nil), closed: make(chan struct{})}
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &parse.Client{
+		Credentials: defaultRestAPIKey,
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			<-ctx.Done()
+			time.Sleep(50 * time.Millisecond)
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		}),
+	}
+	cancel()
+	_, err := c.GetContext(ctx, nil, nil)
+	ensure.DeepEqual(t, err, context.Canceled)
+
+	select {
+	case <-body.closed:
+	case <-time.After(time.Second):
+		t.Fatal("expected the late response body to be closed")
+	}
+}
+
+func TestRetryOn500(t *testing.T) {
+	t.Parallel()
+	var attempts int
+	c := &parse.Client{
+		Credentials:  defaultRestAPIKey,
+		RetryCount:   2,
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: 2 * time.Millisecond,
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			attempts++
+			return &http.Response{
+				StatusCode: 500,
+				Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			}, nil
+		}),
+	}
+	_, err := c.Get(nil, nil)
+	ensure.NotNil(t, err)
+	ensure.DeepEqual(t, attempts, 3)
+}
+
+func TestRetryDoesNotStackUserAgentHeader(t *testing.T) {
+	t.Parallel()
+	var userAgents [][]string
+	c := &parse.Client{
+		Credentials:  defaultRestAPIKey,
+		RetryCount:   2,
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: 2 * time.Millisecond,
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			userAgents = append(userAgents, r.Header["User-Agent"])
+			return &http.Response{
+				StatusCode: 500,
+				Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			}, nil
+		}),
+	}
+	_, err := c.Get(nil, nil)
+	ensure.NotNil(t, err)
+	ensure.DeepEqual(t, len(userAgents), 3)
+	for _, ua := range userAgents {
+		ensure.DeepEqual(t, len(ua), 1)
+	}
+}
+
+func TestRetryRetryAfterSeconds(t *testing.T) {
+	t.Parallel()
+	var attempts int
+	c := &parse.Client{
+		Credentials:  defaultRestAPIKey,
+		RetryCount:   1,
+		RetryWaitMin: time.Hour,
+		RetryWaitMax: time.Hour,
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			attempts++
+			if attempts == 1 {
+				return &http.Response{
+					StatusCode: 429,
+					Header:     http.Header{"Retry-After": []string{"1"}},
+					Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+				}, nil
+			}
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+			}, nil
+		}),
+	}
+	_, err := c.Get(nil, nil)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, attempts, 2)
+}
+
+func TestRetryDoesNotRetryPostByDefault(t *testing.T) {
+	t.Parallel()
+	var attempts int
+	c := &parse.Client{
+		Credentials: defaultRestAPIKey,
+		RetryCount:  2,
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			attempts++
+			return &http.Response{
+				StatusCode: 500,
+				Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			}, nil
+		}),
+	}
+	_, err := c.Post(nil, nil, nil)
+	ensure.NotNil(t, err)
+	ensure.DeepEqual(t, attempts, 1)
+}
+
+func TestRetryPolicyOverride(t *testing.T) {
+	t.Parallel()
+	var attempts int
+	c := &parse.Client{
+		Credentials: defaultRestAPIKey,
+		RetryCount:  1,
+		RetryPolicy: func(res *http.Response, err error) (bool, time.Duration) {
+			return attempts == 1, time.Millisecond
+		},
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			attempts++
+			return &http.Response{
+				StatusCode: 400,
+				Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			}, nil
+		}),
+	}
+	_, err := c.Post(nil, nil, nil)
+	ensure.NotNil(t, err)
+	ensure.DeepEqual(t, attempts, 2)
+}
+
+func TestRetryOnParseTimeoutErrorCode(t *testing.T) {
+	t.Parallel()
+	var attempts int
+	c := &parse.Client{
+		Credentials:  defaultRestAPIKey,
+		RetryCount:   1,
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: time.Millisecond,
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			attempts++
+			return &http.Response{
+				StatusCode: 400,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"code":124,"error":"timeout"}`)),
+			}, nil
+		}),
+	}
+	_, err := c.Get(nil, nil)
+	ensure.NotNil(t, err)
+	ensure.DeepEqual(t, attempts, 2)
+}
+
 func TestNilGetWithDefaultBaseURL(t *testing.T) {
 	t.Parallel()
 	done := make(chan struct{})
@@ -0,0 +1,337 @@
+package parse
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// GeoPoint is a Parse GeoPoint, used as the value for Near and WithinGeoBox
+// query constraints.
+type GeoPoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// MarshalJSON implements json.Marshaler, adding the __type discriminator
+// Parse expects on GeoPoint values.
+func (g GeoPoint) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type      string  `json:"__type"`
+		Latitude  float64 `json:"latitude"`
+		Longitude float64 `json:"longitude"`
+	}{
+		Type:      "GeoPoint",
+		Latitude:  g.Latitude,
+		Longitude: g.Longitude,
+	})
+}
+
+var validRegexOptions = map[rune]bool{'i': true, 'm': true, 'x': true, 's': true}
+
+// Query is a typed builder for the operators understood by Parse's `where`
+// clause. It implements Param, so it can be passed anywhere a Param is
+// expected (e.g. ParamValues) instead of hand-building the equivalent map
+// and passing it to ParamWhere.
+//
+// Besides the where clause, any Query also builds the order, limit, skip,
+// include and keys params set on it. A Query returned by (*Client).NewQuery
+// additionally carries a className and the Client it was built from,
+// letting it execute itself via Find, First and Count.
+type Query struct {
+	conditions map[string]interface{}
+	err        error
+
+	client    *Client
+	className string
+	order     []string
+	include   []string
+	keys      []string
+	limit     uint64
+	limitSet  bool
+	skip      uint64
+}
+
+// NewQuery returns an empty Query ready to have constraints added to it.
+func NewQuery() *Query {
+	return &Query{conditions: make(map[string]interface{})}
+}
+
+// NewQuery returns an empty Query against className, ready to have
+// constraints added to it and executed with Find, First or Count.
+//
+//	c.NewQuery("GameScore").
+//		EqualTo("playerName", "Sean").
+//		GreaterThan("score", 1000).
+//		Include("player").
+//		Order("-score").
+//		Limit(50)
+func (c *Client) NewQuery(className string) *Query {
+	return &Query{
+		conditions: make(map[string]interface{}),
+		client:     c,
+		className:  className,
+	}
+}
+
+func (q *Query) op(field, op string, value interface{}) *Query {
+	if q.err != nil {
+		return q
+	}
+	existing, _ := q.conditions[field].(map[string]interface{})
+	if existing == nil {
+		existing = make(map[string]interface{})
+	}
+	existing[op] = value
+	q.conditions[field] = existing
+	return q
+}
+
+// EqualTo constrains field to equal value.
+func (q *Query) EqualTo(field string, value interface{}) *Query {
+	if q.err != nil {
+		return q
+	}
+	q.conditions[field] = value
+	return q
+}
+
+// NotEqualTo constrains field to not equal value.
+func (q *Query) NotEqualTo(field string, value interface{}) *Query {
+	return q.op(field, "$ne", value)
+}
+
+// GreaterThan constrains field to be greater than value.
+func (q *Query) GreaterThan(field string, value interface{}) *Query {
+	return q.op(field, "$gt", value)
+}
+
+// LessThan constrains field to be less than value.
+func (q *Query) LessThan(field string, value interface{}) *Query {
+	return q.op(field, "$lt", value)
+}
+
+// GreaterThanOrEqualTo constrains field to be greater than or equal to value.
+func (q *Query) GreaterThanOrEqualTo(field string, value interface{}) *Query {
+	return q.op(field, "$gte", value)
+}
+
+// LessThanOrEqualTo constrains field to be less than or equal to value.
+func (q *Query) LessThanOrEqualTo(field string, value interface{}) *Query {
+	return q.op(field, "$lte", value)
+}
+
+// ContainedIn constrains field to be one of the given values.
+func (q *Query) ContainedIn(field string, values interface{}) *Query {
+	return q.op(field, "$in", values)
+}
+
+// NotContainedIn constrains field to not be one of the given values.
+func (q *Query) NotContainedIn(field string, values interface{}) *Query {
+	return q.op(field, "$nin", values)
+}
+
+// Exists constrains field to be present on the object.
+func (q *Query) Exists(field string) *Query {
+	return q.op(field, "$exists", true)
+}
+
+// DoesNotExist constrains field to be absent from the object.
+func (q *Query) DoesNotExist(field string) *Query {
+	return q.op(field, "$exists", false)
+}
+
+// MatchesRegex constrains field to match pattern. options may combine
+// Parse's supported regex modifiers: i, m, x and s.
+func (q *Query) MatchesRegex(field, pattern, options string) *Query {
+	if q.err != nil {
+		return q
+	}
+	for _, r := range options {
+		if !validRegexOptions[r] {
+			q.err = fmt.Errorf("parse: invalid regex option %q", r)
+			return q
+		}
+	}
+	q.op(field, "$regex", pattern)
+	if options != "" {
+		q.op(field, "$options", options)
+	}
+	return q
+}
+
+// Near constrains field to be a GeoPoint, sorting results by proximity to
+// point.
+func (q *Query) Near(field string, point GeoPoint) *Query {
+	return q.op(field, "$nearSphere", point)
+}
+
+// WithinGeoBox constrains field to be a GeoPoint within the box defined by
+// the southwest and northeast corners.
+func (q *Query) WithinGeoBox(field string, southwest, northeast GeoPoint) *Query {
+	return q.op(field, "$within", map[string]interface{}{
+		"$box": []GeoPoint{southwest, northeast},
+	})
+}
+
+// MatchesQuery constrains field, a pointer or relation, to match objects
+// returned by sub, which must have been built with a className (e.g. via
+// (*Client).NewQuery).
+func (q *Query) MatchesQuery(field string, sub *Query) *Query {
+	if q.err != nil {
+		return q
+	}
+	if sub.err != nil {
+		q.err = sub.err
+		return q
+	}
+	if sub.className == "" {
+		q.err = errors.New("parse: MatchesQuery requires a subquery built with a className")
+		return q
+	}
+	return q.op(field, "$inQuery", map[string]interface{}{
+		"where":     sub.conditions,
+		"className": sub.className,
+	})
+}
+
+// DoesNotMatchQuery constrains field, a pointer or relation, to not match
+// objects returned by sub, which must have been built with a className
+// (e.g. via (*Client).NewQuery).
+func (q *Query) DoesNotMatchQuery(field string, sub *Query) *Query {
+	if q.err != nil {
+		return q
+	}
+	if sub.err != nil {
+		q.err = sub.err
+		return q
+	}
+	if sub.className == "" {
+		q.err = errors.New("parse: DoesNotMatchQuery requires a subquery built with a className")
+		return q
+	}
+	return q.op(field, "$notInQuery", map[string]interface{}{
+		"where":     sub.conditions,
+		"className": sub.className,
+	})
+}
+
+// RelatedTo constrains the query to objects that are related to object via
+// the relation stored under key. It is mutually exclusive with other
+// constraints and should be used alone.
+func (q *Query) RelatedTo(key string, object interface{}) *Query {
+	if q.err != nil {
+		return q
+	}
+	q.conditions["$relatedTo"] = map[string]interface{}{
+		"object": object,
+		"key":    key,
+	}
+	return q
+}
+
+// Order sorts results by the given fields, each applied ascending unless
+// prefixed with "-" for descending, e.g. Order("-score", "playerName").
+func (q *Query) Order(fields ...string) *Query {
+	if q.err != nil {
+		return q
+	}
+	q.order = fields
+	return q
+}
+
+// Include adds relations or pointers to be expanded inline in the results.
+func (q *Query) Include(fields ...string) *Query {
+	if q.err != nil {
+		return q
+	}
+	q.include = append(q.include, fields...)
+	return q
+}
+
+// Keys restricts the fields returned for each result.
+func (q *Query) Keys(fields ...string) *Query {
+	if q.err != nil {
+		return q
+	}
+	q.keys = append(q.keys, fields...)
+	return q
+}
+
+// Limit caps the number of results returned.
+func (q *Query) Limit(limit uint64) *Query {
+	if q.err != nil {
+		return q
+	}
+	q.limit = limit
+	q.limitSet = true
+	return q
+}
+
+// Skip skips the given number of results before returning the rest.
+func (q *Query) Skip(skip uint64) *Query {
+	if q.err != nil {
+		return q
+	}
+	q.skip = skip
+	return q
+}
+
+// Or combines the given subqueries with a logical OR. At least two
+// subqueries are required.
+func Or(queries ...*Query) *Query {
+	return combine("$or", queries)
+}
+
+// And combines the given subqueries with a logical AND. At least two
+// subqueries are required.
+func And(queries ...*Query) *Query {
+	return combine("$and", queries)
+}
+
+func combine(op string, queries []*Query) *Query {
+	if len(queries) < 2 {
+		return &Query{err: fmt.Errorf("parse: %s requires at least two subqueries", op)}
+	}
+	values := make([]map[string]interface{}, 0, len(queries))
+	for _, sub := range queries {
+		if sub.err != nil {
+			return &Query{err: sub.err}
+		}
+		values = append(values, sub.conditions)
+	}
+	return &Query{conditions: map[string]interface{}{op: values}}
+}
+
+// set implements Param.
+func (q *Query) set(v url.Values) error {
+	if q.err != nil {
+		return q.err
+	}
+	if len(q.conditions) != 0 {
+		b, err := json.Marshal(q.conditions)
+		if err != nil {
+			return err
+		}
+		v.Add("where", string(b))
+	}
+	if len(q.order) != 0 {
+		v.Add("order", strings.Join(q.order, ","))
+	}
+	if len(q.include) != 0 {
+		v.Add("include", strings.Join(q.include, ","))
+	}
+	if len(q.keys) != 0 {
+		v.Add("keys", strings.Join(q.keys, ","))
+	}
+	if q.limitSet {
+		v.Add("limit", strconv.FormatUint(q.limit, 10))
+	}
+	if q.skip != 0 {
+		v.Add("skip", strconv.FormatUint(q.skip, 10))
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package parse_test
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/facebookgo/parse"
+)
+
+func TestQueryEqualTo(t *testing.T) {
+	t.Parallel()
+	expected := url.Values{"where": []string{`{"playerName":"Sean"}`}}
+	actual, err := parse.ParamValues(parse.NewQuery().EqualTo("playerName", "Sean"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected:\n%+v\nactual:\n%+v", expected, actual)
+	}
+}
+
+func TestQueryComparisonOperators(t *testing.T) {
+	t.Parallel()
+	expected := url.Values{"where": []string{`{"score":{"$gt":1000,"$lt":3000}}`}}
+	actual, err := parse.ParamValues(
+		parse.NewQuery().GreaterThan("score", 1000).LessThan("score", 3000),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected:\n%+v\nactual:\n%+v", expected, actual)
+	}
+}
+
+func TestQueryContainedIn(t *testing.T) {
+	t.Parallel()
+	expected := url.Values{"where": []string{`{"playerName":{"$in":["Jonathan","Dario"]}}`}}
+	actual, err := parse.ParamValues(
+		parse.NewQuery().ContainedIn("playerName", []string{"Jonathan", "Dario"}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected:\n%+v\nactual:\n%+v", expected, actual)
+	}
+}
+
+func TestQueryExists(t *testing.T) {
+	t.Parallel()
+	expected := url.Values{"where": []string{`{"score":{"$exists":true}}`}}
+	actual, err := parse.ParamValues(parse.NewQuery().Exists("score"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected:\n%+v\nactual:\n%+v", expected, actual)
+	}
+}
+
+func TestQueryMatchesRegex(t *testing.T) {
+	t.Parallel()
+	expected := url.Values{"where": []string{`{"name":{"$options":"i","$regex":"^sean"}}`}}
+	actual, err := parse.ParamValues(parse.NewQuery().MatchesRegex("name", "^sean", "i"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected:\n%+v\nactual:\n%+v", expected, actual)
+	}
+}
+
+func TestQueryMatchesRegexInvalidOption(t *testing.T) {
+	t.Parallel()
+	_, err := parse.ParamValues(parse.NewQuery().MatchesRegex("name", "^sean", "q"))
+	if err == nil {
+		t.Fatal("was expecting error")
+	}
+}
+
+func TestQueryOr(t *testing.T) {
+	t.Parallel()
+	expected := url.Values{
+		"where": []string{
+			`{"$or":[{"score":{"$gt":1000}},{"playerName":"Sean"}]}`,
+		},
+	}
+	actual, err := parse.ParamValues(parse.Or(
+		parse.NewQuery().GreaterThan("score", 1000),
+		parse.NewQuery().EqualTo("playerName", "Sean"),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected:\n%+v\nactual:\n%+v", expected, actual)
+	}
+}
+
+func TestQueryOrRequiresTwo(t *testing.T) {
+	t.Parallel()
+	_, err := parse.ParamValues(parse.Or(parse.NewQuery().EqualTo("a", 1)))
+	if err == nil {
+		t.Fatal("was expecting error")
+	}
+}
+
+func TestQueryNear(t *testing.T) {
+	t.Parallel()
+	expected := url.Values{
+		"where": []string{
+			`{"location":{"$nearSphere":{"__type":"GeoPoint","latitude":1.5,"longitude":2.5}}}`,
+		},
+	}
+	actual, err := parse.ParamValues(
+		parse.NewQuery().Near("location", parse.GeoPoint{Latitude: 1.5, Longitude: 2.5}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected:\n%+v\nactual:\n%+v", expected, actual)
+	}
+}
@@ -0,0 +1,51 @@
+package parse
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+)
+
+const requestIDHeader = "X-Request-Id"
+
+type requestIDContextKey struct{}
+
+// WithRequestID returns a copy of ctx carrying id. A Client will use it to
+// populate the X-Request-Id header on outgoing requests that don't already
+// carry one, letting upstream HTTP handlers propagate their own request id
+// into the Parse calls they make.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, id)
+}
+
+// RequestIDFromContext returns the request id previously stored in ctx by
+// WithRequestID, and whether one was present.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDContextKey{}).(string)
+	return id, ok
+}
+
+func generateRequestID() string {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b[:])
+}
+
+// requestID returns the X-Request-Id to use for req: whatever it already
+// carries, the one injected into ctx via WithRequestID, or one freshly
+// minted by RequestIDFunc (falling back to a random id when unset).
+func (c *Client) requestID(ctx context.Context, req *http.Request) string {
+	if id := req.Header.Get(requestIDHeader); id != "" {
+		return id
+	}
+	if id, ok := RequestIDFromContext(ctx); ok && id != "" {
+		return id
+	}
+	if c.RequestIDFunc != nil {
+		return c.RequestIDFunc()
+	}
+	return generateRequestID()
+}
@@ -0,0 +1,81 @@
+package parse_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/facebookgo/ensure"
+	"github.com/facebookgo/parse"
+)
+
+func TestRequestIDGenerated(t *testing.T) {
+	t.Parallel()
+	done := make(chan struct{})
+	c := &parse.Client{
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			defer close(done)
+			ensure.NotDeepEqual(t, r.Header.Get("X-Request-Id"), "")
+			return nil, errors.New("")
+		}),
+	}
+	c.Do(&http.Request{}, nil, nil)
+	<-done
+}
+
+func TestRequestIDFunc(t *testing.T) {
+	t.Parallel()
+	done := make(chan struct{})
+	c := &parse.Client{
+		RequestIDFunc: func() string { return "custom-id" },
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			defer close(done)
+			ensure.DeepEqual(t, r.Header.Get("X-Request-Id"), "custom-id")
+			return nil, errors.New("")
+		}),
+	}
+	c.Do(&http.Request{}, nil, nil)
+	<-done
+}
+
+func TestRequestIDFromContext(t *testing.T) {
+	t.Parallel()
+	done := make(chan struct{})
+	c := &parse.Client{
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			defer close(done)
+			ensure.DeepEqual(t, r.Header.Get("X-Request-Id"), "from-ctx")
+			return nil, errors.New("")
+		}),
+	}
+	ctx := parse.WithRequestID(context.Background(), "from-ctx")
+	c.DoContext(ctx, &http.Request{}, nil, nil)
+	<-done
+}
+
+func TestErrorIncludesRequestID(t *testing.T) {
+	t.Parallel()
+	c := &parse.Client{
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Header:     http.Header{"X-Request-Id": []string{r.Header.Get("X-Request-Id")}},
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"code":101,"error":"not found"}`))),
+			}, nil
+		}),
+	}
+	ctx := parse.WithRequestID(context.Background(), "echoed-id")
+	_, err := c.DoContext(ctx, &http.Request{}, nil, nil)
+	ensure.NotNil(t, err)
+
+	apiErr, ok := err.(*parse.Error)
+	if !ok {
+		t.Fatalf("expected a *parse.Error, got %T", err)
+	}
+	ensure.DeepEqual(t, apiErr.RequestID, "echoed-id")
+	ensure.DeepEqual(t, apiErr.HTTPStatus, http.StatusNotFound)
+	ensure.StringContains(t, err.Error(), "request_id=echoed-id")
+}